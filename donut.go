@@ -29,122 +29,162 @@ Quick Implementation Overview:
 package main
 
 import (
+	"flag"
 	"fmt"
 	"math"
+	"os"
+	"os/signal"
 	"time"
+
+	"donut/export"
+	"donut/render"
+	"donut/shape"
 )
 
 // Screen and sampling constant for our visualization
 const (
     screenWidth  = 40 // Width of the output canvas in characters
     screenHeight = 20 // Height of the output canvas in characters
-    thetaSpacing = 0.07 // Angular step for the main torus circle (affects detail level)
-    phiSpacing   = 0.02 // Angular step for the torus tube (affects smoothness)
 )
 
-// ANSI color sequences for kind of rainbow effect
-// Each color is represented by its escape sequence
-var colors = []string{
-    "\033[31m", // Red
-    "\033[33m", // Yellow
-    "\033[32m", // Green
-    "\033[36m", // Cyan
-    "\033[34m", // Blue
-    "\033[35m", // Magenta
+// mode selects which renderer main runs. "hypertorus" drives the 4D
+// duocylinder with keyboard trackball controls; "torus" renders the classic
+// single donut through the fixed donut/shape.Torus and donut/torus.Params
+// projection; "scene" exercises the donut/render scene graph with a cube
+// orbiting through the donut; "export" renders the torus and a spinning
+// cube parked beside it (not orbiting) headlessly to a file instead of the
+// terminal.
+var mode = flag.String("mode", "torus", `which to render: "torus", "hypertorus", "scene", or "export"`)
+var exportFormat = flag.String("export-format", "flipbook", `export mode only: "cast", "gif", or "flipbook"`)
+var exportFrames = flag.Int("export-frames", 120, "export mode only: number of frames to render")
+var exportOut = flag.String("export-out", "donut.out", "export mode only: output file path")
+
+func main() {
+    flag.Parse()
+    switch *mode {
+    case "hypertorus":
+        runHypertorus()
+    case "scene":
+        runScene()
+    case "export":
+        runExport()
+    default:
+        runTorus()
+    }
 }
 
+// runExport renders the scene/shapes frame-by-frame via donut/export
+// (no fmt.Print, no time.Sleep) and encodes the result to -export-out in
+// the format named by -export-format.
+func runExport() {
+    torus := shape.NewTorus(screenWidth, screenHeight)
+    cube := shape.NewCube(0.6, screenWidth, screenHeight)
+    cube.Dist, cube.K1, cube.K2 = torus.Dist, torus.K1, torus.K2
+    cube.SpeedX, cube.SpeedY = 0.05, 0.08
+    cube.Center = [3]float64{2.5, 0, 0}
 
-func main() {
-    // Initialize rendering buffers
-    zBuffer := make([]float64, screenWidth*screenHeight) // Depth buffer for 3D projection
-    output := make([]byte, screenWidth*screenHeight)     // Character buffer for ASCII output
-    
-    // Luminance mapping characters from darkest to brightest
-    // Provides visual depth through ASCII character density
-    luminanceChars := []byte(".,-~:;=!*#$@")
-    
-    // Rotation angles for 3D transformation
-    // A: rotation around X-axis
-    // B: rotation around Z-axis
-    A, B := 0.0, 0.0
+    frames := export.Render(export.Config{
+        Width: screenWidth, Height: screenHeight,
+        Shapes:   []shape.Shape{torus, cube},
+        Spinners: []export.Spinner{torus, cube},
+    }, *exportFrames)
+
+    f, err := os.Create(*exportOut)
+    if err != nil {
+        fmt.Fprintln(os.Stderr, "donut: export:", err)
+        os.Exit(1)
+    }
+    defer f.Close()
+
+    switch *exportFormat {
+    case "cast":
+        err = export.WriteCast(f, frames, 20)
+    case "gif":
+        err = export.WriteGIF(f, frames, 50*time.Millisecond)
+    default:
+        err = export.WriteFlipbook(f, frames)
+    }
+    if err != nil {
+        fmt.Fprintln(os.Stderr, "donut: export:", err)
+        os.Exit(1)
+    }
+}
+
+// runScene composites a Torus and an orbiting Cube through a single
+// donut/render.Frame, so the wireframe cube occludes and is occluded by
+// the solid torus purely based on the shared z-buffer.
+func runScene() {
+    frame := render.NewFrame(screenWidth, screenHeight)
+    torus := shape.NewTorus(screenWidth, screenHeight)
+    cube := shape.NewCube(0.6, screenWidth, screenHeight)
+    cube.Dist, cube.K1, cube.K2 = torus.Dist, torus.K1, torus.K2
+    cube.SpeedX, cube.SpeedY = 0.05, 0.08
+
+    orbitAngle := 0.0
+    const orbitRadius = 2.5
+    const orbitSpeed = 0.02
+
+    for {
+        frame.Clear()
+
+        cube.Center = [3]float64{orbitRadius * math.Cos(orbitAngle), 0, orbitRadius * math.Sin(orbitAngle)}
+        frame.Composite(torus, cube)
+        frame.Print()
+
+        torus.Spin()
+        cube.Spin()
+        orbitAngle += orbitSpeed
+        time.Sleep(50 * time.Millisecond)
+    }
+}
+
+// runHypertorus drives a 4D duocylinder through donut/shape, steered live
+// by arrow keys/WASD/QE via a non-blocking Trackball reading os.Stdin.
+// NewTrackball switches stdin into cbreak mode for this; restore it on
+// Ctrl-C instead of leaving the terminal unreadable after exit.
+func runHypertorus() {
+    ht := shape.NewHypertorus(screenWidth, screenHeight)
+    trackball := shape.NewTrackball(ht, os.Stdin)
+
+    sigCh := make(chan os.Signal, 1)
+    signal.Notify(sigCh, os.Interrupt)
+    go func() {
+        <-sigCh
+        trackball.Close()
+        os.Exit(0)
+    }()
+
+    frame := render.NewFrame(screenWidth, screenHeight)
+
+    for {
+        trackball.Poll()
+
+        frame.Clear()
+        frame.Composite(ht)
+        frame.Print()
+
+        ht.Spin()
+        time.Sleep(50 * time.Millisecond)
+    }
+}
+
+// runTorus renders the classic single donut through donut/shape.Torus and
+// donut/render.Frame. It used to be a hand-rolled loop with its own normal
+// calculation and hardcoded 15/7 screen-space constants that only looked
+// right at exactly 40x20; both bugs are now fixed upstream, in
+// shape.Torus.Walk (a real 3D normal from the same rotation as the point
+// itself) and donut/torus.Params.Compute (K1/K2 derived from screen size),
+// so this just drives them like runScene does for the multi-shape case.
+func runTorus() {
+    frame := render.NewFrame(screenWidth, screenHeight)
+    tr := shape.NewTorus(screenWidth, screenHeight)
 
-    colorIndex := 0
-    
     for {
-        // Clear buffers for new frame
-        // Prevents ghosting and ensures cleaner rendering
-        for i := range output {
-            output[i] = ' '
-            zBuffer[i] = 0
-        }
-        
-        // Pre-calculate trigonometric values for optimization
-        // Reduces redundant calculations in the rendering loop
-        sinA, cosA := math.Sin(A), math.Cos(A)
-        sinB, cosB := math.Sin(B), math.Cos(B)
-
-        // Iterates through all points on the torus surface
-        for theta := 0.0; theta < 2*math.Pi; theta += thetaSpacing {
-            for phi := 0.0; phi < 2*math.Pi; phi += phiSpacing {
-                // Calculate 3D coordinates on torus surface
-                // Using parametric equations for torus generation
-                sinPhi, cosPhi := math.Sin(phi), math.Cos(phi)
-                sinTheta, cosTheta := math.Sin(theta), math.Cos(theta)
-                
-                // Calculate donut surface point
-                h := cosTheta + 2 // Distance from center to torus tube
-                
-                // Calculate depth (D) and transformation (t) values
-                // D: Used for z-buffering and perspective
-                                // t: Used for rotation transformation
-                                D := 1 / (sinPhi*h*sinA + sinTheta*cosA + 5) // 5 is the viewing distance
-                                t := sinPhi*h*cosA - sinTheta*sinA
-                
-                                // Project 3D coordinates to 2D screen space
-                                // Applies perspective division and screen space transformation
-                                x := int(screenWidth/2 + 15*D*(cosPhi*h*cosB - t*sinB))
-                                y := int(screenHeight/2 + 7*D*(cosPhi*h*sinB + t*cosB))
-                
-                                // Calculate surface normal for lighting
-                                // N determines the luminance value for each point
-                                N := int(8 * ((sinTheta*sinA - sinPhi*cosTheta*cosA) * cosB - 
-                                     sinPhi*cosTheta*sinA - sinTheta*cosA - cosPhi*cosTheta*sinB))
-                
-                                // Screen space boundary check and z-buffer comparison
-                                pos := x + screenWidth*y
-                                if y >= 0 && y < screenHeight && x >= 0 && x < screenWidth {
-                                    if D > zBuffer[pos] { // Z-buffer check for depth ordering
-                                        zBuffer[pos] = D
-                                        // Map normal to ASCII character based on luminance
-                                        if N > 0 {
-                                            output[pos] = luminanceChars[N%12]
-                                        } else {
-                                            output[pos] = luminanceChars[0]
-                                        }
-                                    }
-                                }
-                            }
-                        }
-                
-                        // Render frame to terminal
-                        fmt.Print("\033[H") // Reset cursor to home position
-                        
-                        // Output rendered frame with color
-                        currentColor := colors[colorIndex]
-                        for i := 0; i < screenHeight; i++ {
-                            fmt.Print(currentColor)
-                            for j := 0; j < screenWidth; j++ {
-                                fmt.Printf("%c", output[i*screenWidth+j])
-                            }
-                            fmt.Println("\033[0m") // Reset color at end of line
-                        }
-                
-                        // Update rotation angles for next frame
-                        // Controls the rotation speed and direction
-                        A += 0.07 // X-axis rotation increment
-                        B += 0.03 // Z-axis rotation increment
-                        
-                        // 50ms delay provides animation at around ~20 FPS
-                        time.Sleep(50 * time.Millisecond)
-                    }
-                }
+        frame.Clear()
+        frame.Composite(tr)
+        frame.Print()
+
+        tr.Spin()
+        time.Sleep(50 * time.Millisecond)
+    }
+}
@@ -0,0 +1,67 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// castHeader is the first line of an asciinema v2 .cast file.
+type castHeader struct {
+	Version   int    `json:"version"`
+	Width     int    `json:"width"`
+	Height    int    `json:"height"`
+	Timestamp int64  `json:"timestamp"`
+	Title     string `json:"title,omitempty"`
+}
+
+// WriteCast encodes frames as an asciinema v2 .cast file to w, played back
+// at a constant fps. Each frame becomes one "output" event carrying the
+// full redraw (cursor-home + coloured rows), the same redraw the live
+// terminal loop prints per frame apart from the line endings (see
+// renderANSI).
+func WriteCast(w io.Writer, frames []Frame, fps float64) error {
+	if len(frames) == 0 {
+		return nil
+	}
+	if fps <= 0 {
+		fps = 20
+	}
+
+	enc := json.NewEncoder(w)
+	header := castHeader{Version: 2, Width: frames[0].Width, Height: frames[0].Height, Title: "donut"}
+	if err := enc.Encode(header); err != nil {
+		return err
+	}
+
+	frameDuration := 1 / fps
+	for i, frame := range frames {
+		t := float64(i) * frameDuration
+		data := renderANSI(frame)
+		// asciicast events are 3-element arrays: [time, "o", data].
+		event := [3]interface{}{t, "o", data}
+		if err := enc.Encode(event); err != nil {
+			return fmt.Errorf("export: encode cast event %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// renderANSI reproduces what the live terminal renderer prints for one
+// frame: cursor-home, then each row in its shape's colour. Rows end in
+// "\r\n" here, where render.Frame.Print ends them in a bare "\n".
+func renderANSI(f Frame) string {
+	var out []byte
+	out = append(out, "\033[H"...)
+	for y := 0; y < f.Height; y++ {
+		for x := 0; x < f.Width; x++ {
+			pos := x + f.Width*y
+			if f.Colors[pos] != "" {
+				out = append(out, f.Colors[pos]...)
+			}
+			out = append(out, f.Cells[pos])
+		}
+		out = append(out, "\033[0m\r\n"...)
+	}
+	return string(out)
+}
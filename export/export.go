@@ -0,0 +1,73 @@
+// Package export produces deterministic, headless frames from the
+// donut/render scene graph for offline encoding (asciicast, GIF,
+// flipbook). It never calls fmt.Print or time.Sleep, so the same scene
+// that drives the live terminal loop can also be captured frame-by-frame
+// and diffed against golden files in a test.
+package export
+
+import (
+	"donut/render"
+	"donut/shape"
+)
+
+// Spinner advances a shape's rotation state by one frame. shape.Torus,
+// shape.Cube, and shape.Hypertorus all already satisfy this via their
+// existing Spin methods.
+type Spinner interface {
+	Spin()
+}
+
+// Config describes the scene to render. Shapes are composited in order
+// each frame (later shapes can still be occluded by earlier ones, purely
+// on depth); Spinners are advanced once per frame, after compositing, in
+// the order given.
+type Config struct {
+	Width, Height int
+	Shapes        []shape.Shape
+	Spinners      []Spinner
+}
+
+// Frame is one rendered character+colour grid, row-major and Width*Height
+// long, decoupled from any particular output format or from the terminal.
+// RGB carries the unquantized shaded colour alongside Colors' terminal
+// escape, for encoders (like the GIF one) that want the real colour
+// instead of parsing it back out of an ANSI string.
+type Frame struct {
+	Width, Height int
+	Cells         []byte
+	Colors        []string
+	RGB           [][3]float64
+}
+
+// Render runs cfg's scene for nFrames steps and returns every frame in
+// rendering order. It performs no I/O and advances no wall-clock timer, so
+// shapes in the same starting state always produce the same Cells and RGB.
+// Colors use the colour mode render.NewFrame detects from the environment,
+// and the Spinners leave cfg's shapes advanced by nFrames steps, so a
+// second call with the same cfg continues from there.
+func Render(cfg Config, nFrames int) []Frame {
+	f := render.NewFrame(cfg.Width, cfg.Height)
+	frames := make([]Frame, 0, nFrames)
+
+	for i := 0; i < nFrames; i++ {
+		f.Clear()
+		f.Composite(cfg.Shapes...)
+		frames = append(frames, snapshot(f))
+		for _, s := range cfg.Spinners {
+			s.Spin()
+		}
+	}
+	return frames
+}
+
+// snapshot copies a render.Frame's buffers into a standalone Frame so
+// later calls to f.Clear() can't mutate frames already returned.
+func snapshot(f *render.Frame) Frame {
+	cells := make([]byte, len(f.Cells()))
+	copy(cells, f.Cells())
+	colors := make([]string, len(f.Colors()))
+	copy(colors, f.Colors())
+	rgb := make([][3]float64, len(f.RGB()))
+	copy(rgb, f.RGB())
+	return Frame{Width: f.Width, Height: f.Height, Cells: cells, Colors: colors, RGB: rgb}
+}
@@ -0,0 +1,52 @@
+package export_test
+
+import (
+	"bytes"
+	"testing"
+
+	"donut/export"
+)
+
+// goldenFrames are two tiny, hand-built frames (bypassing export.Render, so
+// no terminal-dependent colour mode is involved) used to pin WriteFlipbook's
+// and WriteCast's exact byte output.
+var goldenFrames = []export.Frame{
+	{
+		Width: 2, Height: 2,
+		Cells:  []byte("abcd"),
+		Colors: []string{"", "\033[31m", "", ""},
+		RGB:    [][3]float64{{0, 0, 0}, {1, 0, 0}, {0, 0, 0}, {0, 0, 0}},
+	},
+	{
+		Width: 2, Height: 2,
+		Cells:  []byte("efgh"),
+		Colors: []string{"", "", "\033[32m", ""},
+		RGB:    [][3]float64{{0, 0, 0}, {0, 0, 0}, {0, 1, 0}, {0, 0, 0}},
+	},
+}
+
+func TestWriteFlipbookGolden(t *testing.T) {
+	const want = "ab\ncd\n\fef\ngh\n"
+
+	var buf bytes.Buffer
+	if err := export.WriteFlipbook(&buf, goldenFrames); err != nil {
+		t.Fatalf("WriteFlipbook: %v", err)
+	}
+	if got := buf.String(); got != want {
+		t.Errorf("flipbook output does not match golden output\ngot:\n%q\nwant:\n%q", got, want)
+	}
+}
+
+func TestWriteCastGolden(t *testing.T) {
+	want := "{\"version\":2,\"width\":2,\"height\":2,\"timestamp\":0,\"title\":\"donut\"}\n" +
+		"[0,\"o\",\"\\u001b[Ha\\u001b[31mb\\u001b[0m\\r\\ncd\\u001b[0m\\r\\n\"]\n" +
+		"[0.05,\"o\",\"\\u001b[Hef\\u001b[0m\\r\\n\\u001b[32mgh\\u001b[0m\\r\\n\"]\n"
+
+	var buf bytes.Buffer
+	if err := export.WriteCast(&buf, goldenFrames, 20); err != nil {
+		t.Fatalf("WriteCast: %v", err)
+	}
+	if got := buf.String(); got != want {
+		t.Errorf("cast output does not match golden output\ngot:\n%q\nwant:\n%q", got, want)
+	}
+}
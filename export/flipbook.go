@@ -0,0 +1,36 @@
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+)
+
+// frameSeparator is printed between consecutive frames in a flipbook so a
+// plain-text viewer can tell where one frame ends and the next begins
+// without relying on terminal control codes.
+const frameSeparator = "\f"
+
+// WriteFlipbook writes frames as plain, colourless text: one row of
+// characters per line, frames separated by a form-feed so tools like
+// `less` or `fold` page through it one frame at a time.
+func WriteFlipbook(w io.Writer, frames []Frame) error {
+	bw := bufio.NewWriter(w)
+	for i, frame := range frames {
+		for y := 0; y < frame.Height; y++ {
+			row := frame.Cells[y*frame.Width : (y+1)*frame.Width]
+			if _, err := bw.Write(row); err != nil {
+				return fmt.Errorf("export: write flipbook frame %d row %d: %w", i, y, err)
+			}
+			if err := bw.WriteByte('\n'); err != nil {
+				return err
+			}
+		}
+		if i < len(frames)-1 {
+			if _, err := bw.WriteString(frameSeparator); err != nil {
+				return err
+			}
+		}
+	}
+	return bw.Flush()
+}
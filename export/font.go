@@ -0,0 +1,40 @@
+package export
+
+// glyphWidth and glyphHeight are the bitmap cell size used to rasterise
+// each character cell into a pixel for the GIF encoder. 3x5 is the
+// smallest size that can tell the luminanceChars glyphs apart from each
+// other at a glance once scaled up.
+const (
+	glyphWidth  = 3
+	glyphHeight = 5
+)
+
+// font maps each glyph this renderer ever emits (the space used to clear
+// a cell, plus Frame's luminanceChars) to a 5-row x 3-bit bitmap, MSB
+// first. Glyphs are hand-drawn approximations of their density, not a
+// faithful font rendering — good enough to tell "empty" from "dense" in
+// an exported GIF.
+var font = map[byte][glyphHeight]byte{
+	' ': {0b000, 0b000, 0b000, 0b000, 0b000},
+	'.': {0b000, 0b000, 0b000, 0b000, 0b010},
+	',': {0b000, 0b000, 0b000, 0b010, 0b100},
+	'-': {0b000, 0b000, 0b111, 0b000, 0b000},
+	'~': {0b000, 0b101, 0b010, 0b000, 0b000},
+	':': {0b010, 0b000, 0b000, 0b010, 0b000},
+	';': {0b010, 0b000, 0b000, 0b010, 0b100},
+	'=': {0b000, 0b111, 0b000, 0b111, 0b000},
+	'!': {0b010, 0b010, 0b010, 0b000, 0b010},
+	'*': {0b101, 0b010, 0b101, 0b010, 0b101},
+	'#': {0b101, 0b111, 0b101, 0b111, 0b101},
+	'$': {0b111, 0b100, 0b111, 0b001, 0b111},
+	'@': {0b111, 0b101, 0b101, 0b101, 0b111},
+}
+
+// glyphFor looks up c's bitmap, falling back to a blank cell for any
+// character that isn't one of the renderer's known glyphs.
+func glyphFor(c byte) [glyphHeight]byte {
+	if g, ok := font[c]; ok {
+		return g
+	}
+	return font[' ']
+}
@@ -0,0 +1,102 @@
+package export
+
+import (
+	"image"
+	"image/color"
+	"image/gif"
+	"io"
+	"time"
+)
+
+// cubeSteps are the per-channel levels buildPalette's colour cube is built
+// from. 6 steps per channel (216 colours total) keeps the whole cube
+// under a GIF palette's 256-entry limit with room to spare, while still
+// giving a real per-shape colour rather than the renderer's old 7-entry
+// ANSI lookup (which only ever matched the 8-colour fallback escapes, so
+// every truecolor or 256-colour cell fell through to plain white).
+var cubeSteps = [6]uint8{0, 51, 102, 153, 204, 255}
+
+var backgroundRGBA = color.RGBA{0, 0, 0, 255}
+
+// buildPalette returns a fixed 6x6x6 RGB cube (216 entries), reused across
+// every frame so encoding never recomputes a palette.
+func buildPalette() color.Palette {
+	pal := make(color.Palette, 0, len(cubeSteps)*len(cubeSteps)*len(cubeSteps))
+	for _, r := range cubeSteps {
+		for _, g := range cubeSteps {
+			for _, b := range cubeSteps {
+				pal = append(pal, color.RGBA{r, g, b, 255})
+			}
+		}
+	}
+	return pal
+}
+
+// quantize maps a shaded RGB triple (each channel in [0, 1], as
+// render.Frame.RGB produces) onto the nearest colour in buildPalette's
+// cube.
+func quantize(rgb [3]float64) color.RGBA {
+	step := func(c float64) uint8 {
+		v := int(c*255 + 0.5)
+		if v < 0 {
+			v = 0
+		} else if v > 255 {
+			v = 255
+		}
+		return cubeSteps[(v*(len(cubeSteps)-1)+127)/255]
+	}
+	return color.RGBA{step(rgb[0]), step(rgb[1]), step(rgb[2]), 255}
+}
+
+// WriteGIF rasterises each frame's characters into a tiny monospace
+// bitmap font (see font.go) and encodes the result as an animated GIF to
+// w, holding each frame for the given delay. Glyphs are coloured from the
+// frame's per-cell RGB, not its ANSI escape, so the exported colours
+// match what shaded the shape regardless of the terminal's own colour
+// mode.
+func WriteGIF(w io.Writer, frames []Frame, delay time.Duration) error {
+	if len(frames) == 0 {
+		return nil
+	}
+	pal := buildPalette()
+	delayHundredths := int(delay / (10 * time.Millisecond))
+	if delayHundredths <= 0 {
+		delayHundredths = 5 // 50ms, matching the live renderer's default frame pace
+	}
+
+	out := &gif.GIF{}
+	imgW := frames[0].Width * glyphWidth
+	imgH := frames[0].Height * glyphHeight
+
+	for _, frame := range frames {
+		img := image.NewPaletted(image.Rect(0, 0, imgW, imgH), pal)
+		for y := 0; y < frame.Height; y++ {
+			for x := 0; x < frame.Width; x++ {
+				pos := x + frame.Width*y
+				drawGlyph(img, x, y, frame.Cells[pos], quantize(frame.RGB[pos]))
+			}
+		}
+		out.Image = append(out.Image, img)
+		out.Delay = append(out.Delay, delayHundredths)
+		out.Disposal = append(out.Disposal, gif.DisposalNone)
+	}
+	return gif.EncodeAll(w, out)
+}
+
+// drawGlyph paints character cell's bitmap at its (col, row) position in
+// img, using fg for set bits and the palette's background for clear ones.
+func drawGlyph(img *image.Paletted, col, row int, char byte, fg color.RGBA) {
+	bitmap := glyphFor(char)
+	baseX, baseY := col*glyphWidth, row*glyphHeight
+	for dy := 0; dy < glyphHeight; dy++ {
+		bits := bitmap[dy]
+		for dx := 0; dx < glyphWidth; dx++ {
+			set := bits&(1<<uint(glyphWidth-1-dx)) != 0
+			c := backgroundRGBA
+			if set {
+				c = fg
+			}
+			img.Set(baseX+dx, baseY+dy, c)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package light
+
+// Light is anything that can report, for a given surface point, the unit
+// direction from that point toward the light and how strongly the light
+// illuminates it there.
+type Light interface {
+	Direction(point [3]float64) (dir [3]float64, intensity float64)
+}
+
+// Directional is a light infinitely far away, like the sun: the same
+// direction and intensity everywhere in the scene.
+type Directional struct {
+	// Dir is the direction the light travels (e.g. {0, -1, 0} shines
+	// straight down); Direction returns its reverse, toward the light.
+	Dir       [3]float64
+	Intensity float64
+}
+
+func (d Directional) Direction(point [3]float64) ([3]float64, float64) {
+	return scale(normalize(d.Dir), -1), d.Intensity
+}
+
+// Point is a light at a fixed position whose intensity falls off with the
+// square of the distance to the surface point, like a real point light.
+type Point struct {
+	Pos       [3]float64
+	Intensity float64
+}
+
+func (p Point) Direction(point [3]float64) ([3]float64, float64) {
+	toLight := sub(p.Pos, point)
+	dist2 := dot(toLight, toLight)
+	if dist2 < 1e-9 {
+		return [3]float64{0, 0, 1}, p.Intensity
+	}
+	return normalize(toLight), p.Intensity / dist2
+}
@@ -0,0 +1,72 @@
+// Package light shades a surface normal against one or more configurable
+// light sources, replacing the renderer's old fixed six-colour ANSI cycle
+// with real Lambert diffuse (and optional Phong specular) shading, emitted
+// as 24-bit ANSI colour where the terminal supports it.
+package light
+
+import "math"
+
+// Material is a shape's own surface appearance, independent of any
+// particular light. Colour channels are in [0, 1].
+type Material struct {
+	Color     [3]float64
+	Ambient   float64 // fraction of Color always visible, even fully unlit
+	Specular  float64 // specular reflectance; 0 disables the highlight entirely
+	Shininess float64 // Phong exponent; higher means a tighter, sharper highlight
+}
+
+// Shade computes Lambert diffuse plus optional Phong specular for a
+// surface point with the given normal, viewed from viewDir (pointing from
+// the surface toward the camera), lit by lights, using m's material
+// properties. The result is clamped to [0, 1] per channel.
+func Shade(point, normal, viewDir [3]float64, m Material, lights []Light) [3]float64 {
+	n := normalize(normal)
+	v := normalize(viewDir)
+	result := scale(m.Color, m.Ambient)
+
+	for _, l := range lights {
+		dir, intensity := l.Direction(point)
+		diff := dot(n, dir)
+		if diff <= 0 {
+			continue
+		}
+		result = add(result, scale(m.Color, diff*intensity))
+
+		if m.Specular > 0 {
+			reflect := sub(scale(n, 2*diff), dir)
+			spec := dot(reflect, v)
+			if spec > 0 {
+				highlight := m.Specular * intensity * math.Pow(spec, m.Shininess)
+				result = add(result, scale([3]float64{1, 1, 1}, highlight))
+			}
+		}
+	}
+	return clamp01(result)
+}
+
+func dot(a, b [3]float64) float64 { return a[0]*b[0] + a[1]*b[1] + a[2]*b[2] }
+
+func add(a, b [3]float64) [3]float64 { return [3]float64{a[0] + b[0], a[1] + b[1], a[2] + b[2]} }
+
+func sub(a, b [3]float64) [3]float64 { return [3]float64{a[0] - b[0], a[1] - b[1], a[2] - b[2]} }
+
+func scale(a [3]float64, s float64) [3]float64 { return [3]float64{a[0] * s, a[1] * s, a[2] * s} }
+
+func normalize(a [3]float64) [3]float64 {
+	length := math.Sqrt(dot(a, a))
+	if length < 1e-9 {
+		return a
+	}
+	return scale(a, 1/length)
+}
+
+func clamp01(a [3]float64) [3]float64 {
+	for i, v := range a {
+		if v < 0 {
+			a[i] = 0
+		} else if v > 1 {
+			a[i] = 1
+		}
+	}
+	return a
+}
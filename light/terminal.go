@@ -0,0 +1,87 @@
+package light
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ColorMode is how much colour depth the current terminal can display.
+type ColorMode int
+
+const (
+	ModeMono ColorMode = iota
+	Mode8
+	Mode256
+	ModeTrueColor
+)
+
+// DetectColorMode inspects COLORTERM and TERM the way most terminal-aware
+// CLIs do: an explicit COLORTERM=truecolor/24bit wins outright; otherwise
+// a TERM containing "256color" gets 256-colour; a "dumb" or empty TERM
+// gets no colour at all; anything else gets the original 8-colour mode.
+func DetectColorMode() ColorMode {
+	switch strings.ToLower(os.Getenv("COLORTERM")) {
+	case "truecolor", "24bit":
+		return ModeTrueColor
+	}
+
+	term := strings.ToLower(os.Getenv("TERM"))
+	switch {
+	case term == "" || term == "dumb":
+		return ModeMono
+	case strings.Contains(term, "256color"):
+		return Mode256
+	default:
+		return Mode8
+	}
+}
+
+// ANSI renders rgb (each channel in [0, 1]) as the escape sequence best
+// suited to mode, degrading gracefully from 24-bit down to the original
+// 8-colour palette (and to no colour at all for ModeMono).
+func ANSI(mode ColorMode, rgb [3]float64) string {
+	r, g, b := to255(rgb[0]), to255(rgb[1]), to255(rgb[2])
+	switch mode {
+	case ModeTrueColor:
+		return fmt.Sprintf("\033[38;2;%d;%d;%dm", r, g, b)
+	case Mode256:
+		return fmt.Sprintf("\033[38;5;%dm", to256(r, g, b))
+	case Mode8:
+		return to8(r, g, b)
+	default:
+		return ""
+	}
+}
+
+func to255(c float64) int {
+	v := int(c*255 + 0.5)
+	if v < 0 {
+		return 0
+	}
+	if v > 255 {
+		return 255
+	}
+	return v
+}
+
+// to256 maps 8-bit RGB onto the xterm 256-colour cube (indices 16-231,
+// a 6x6x6 cube of colour steps).
+func to256(r, g, b int) int {
+	step := func(c int) int { return c * 5 / 255 }
+	return 16 + 36*step(r) + 6*step(g) + step(b)
+}
+
+// to8 maps 8-bit RGB onto the original ANSI 8-colour escape codes by
+// rounding each channel to on/off, the same fallback the classic
+// 6-entry rainbow palette used.
+func to8(r, g, b int) string {
+	bit := func(c int) int {
+		if c > 127 {
+			return 1
+		}
+		return 0
+	}
+	code := 30 + bit(r) + bit(g)*2 + bit(b)*4
+	return fmt.Sprintf("\033[%dm", code)
+}
@@ -0,0 +1,122 @@
+// Package render composites any number of shape.Shape values into a single
+// ASCII frame, sharing one z-buffer so shapes occlude each other correctly
+// regardless of the order they're drawn in, and shading every sample
+// against a configurable set of lights.
+package render
+
+import (
+	"fmt"
+
+	"donut/light"
+	"donut/shape"
+)
+
+// viewDir points from the scene toward the camera; the renderer always
+// looks down -z, the same convention every Shape already projects with.
+var viewDir = [3]float64{0, 0, 1}
+
+// Frame owns the depth, character, and colour buffers for one screen-sized
+// frame. Reuse a single Frame across the render loop and call Clear
+// between frames instead of allocating a new one.
+type Frame struct {
+	Width, Height  int
+	Lights         []light.Light
+	ColorMode      light.ColorMode
+	zBuffer        []float64
+	output         []byte
+	colorOf        []string
+	rgbOf          [][3]float64
+	luminanceChars []byte
+}
+
+// NewFrame allocates a Frame sized for a width x height screen, with a
+// single default key light and whatever colour depth DetectColorMode
+// finds for the current terminal. Override Lights/ColorMode afterward to
+// customise either.
+func NewFrame(width, height int) *Frame {
+	return &Frame{
+		Width: width, Height: height,
+		Lights: []light.Light{
+			light.Directional{Dir: [3]float64{-0.4, -0.5, -0.8}, Intensity: 1},
+		},
+		ColorMode:      light.DetectColorMode(),
+		zBuffer:        make([]float64, width*height),
+		output:         make([]byte, width*height),
+		colorOf:        make([]string, width*height),
+		rgbOf:          make([][3]float64, width*height),
+		luminanceChars: []byte(".,-~:;=!*#$@"),
+	}
+}
+
+// Clear resets every cell ahead of the next Composite call.
+func (f *Frame) Clear() {
+	for i := range f.output {
+		f.output[i] = ' '
+		f.zBuffer[i] = 0
+		f.colorOf[i] = ""
+		f.rgbOf[i] = [3]float64{}
+	}
+}
+
+// Composite walks every shape in shapes and z-tests its samples against
+// the shared buffer, so later shapes can still be occluded by earlier ones
+// (and vice versa) purely on depth, never on draw order. Each surviving
+// sample is shaded against f.Lights using the shape's own Material.
+func (f *Frame) Composite(shapes ...shape.Shape) {
+	for _, s := range shapes {
+		material := s.Material()
+		s.Walk(f.Width, f.Height, func(p shape.Point) {
+			if p.Y < 0 || p.Y >= f.Height || p.X < 0 || p.X >= f.Width {
+				return
+			}
+			pos := p.X + f.Width*p.Y
+			if p.Depth <= f.zBuffer[pos] {
+				return
+			}
+			f.zBuffer[pos] = p.Depth
+
+			rgb := light.Shade(p.Pos, p.Normal, viewDir, material, f.Lights)
+			brightness := (rgb[0] + rgb[1] + rgb[2]) / 3
+			idx := int(brightness * float64(len(f.luminanceChars)))
+			if idx >= len(f.luminanceChars) {
+				idx = len(f.luminanceChars) - 1
+			}
+			f.output[pos] = f.luminanceChars[idx]
+			f.colorOf[pos] = light.ANSI(f.ColorMode, rgb)
+			f.rgbOf[pos] = rgb
+		})
+	}
+}
+
+// Cells returns the frame's character buffer, row-major, Width*Height
+// long. Callers must not mutate the returned slice.
+func (f *Frame) Cells() []byte { return f.output }
+
+// Colors returns the per-cell ANSI colour escape sequence, parallel to
+// Cells ("" where no shape painted that cell). Callers must not mutate the
+// returned slice.
+func (f *Frame) Colors() []string { return f.colorOf }
+
+// RGB returns the per-cell shaded colour Colors was rendered from, each
+// channel in [0, 1] and parallel to Cells (the zero value where no shape
+// painted that cell). Unlike Colors, this survives terminal-specific
+// quantization, so callers that need the real colour (e.g. encoding an
+// image) should use this instead of parsing an ANSI escape back apart.
+// Callers must not mutate the returned slice.
+func (f *Frame) RGB() [][3]float64 { return f.rgbOf }
+
+// Print writes the frame to stdout, homing the cursor first so the
+// terminal repaints in place instead of scrolling.
+func (f *Frame) Print() {
+	fmt.Print("\033[H")
+	for y := 0; y < f.Height; y++ {
+		for x := 0; x < f.Width; x++ {
+			pos := x + f.Width*y
+			if f.colorOf[pos] != "" {
+				fmt.Print(f.colorOf[pos])
+			}
+			fmt.Printf("%c", f.output[pos])
+		}
+		fmt.Println("\033[0m")
+	}
+}
@@ -0,0 +1,141 @@
+package shape
+
+import (
+	"math"
+
+	"donut/light"
+	"donut/torus"
+)
+
+// cubeVertexSigns are the eight corners of an axis-aligned cube at ±1 in
+// each axis, ordered so index 0 sits opposite the vertex on the main
+// diagonal — the layout used by the Rosetta Code "Draw a rotating cube"
+// task. The ordering alone only fixes which corner is which; getting that
+// task's actual rest pose, with the main diagonal pointing straight up,
+// is restTilt's job.
+var cubeVertexSigns = [8][3]float64{
+	{-1, -1, -1}, {1, -1, -1}, {1, 1, -1}, {-1, 1, -1},
+	{-1, -1, 1}, {1, -1, 1}, {1, 1, 1}, {-1, 1, 1},
+}
+
+// restTilt rotates a point 45° about Y then atan(√2) (≈54.7356°, the
+// "magic angle") about Z. Applied to cubeVertexSigns' ±1 corners, this
+// sends the (-1,-1,-1)/(1,1,1) main diagonal onto the Y axis, so the cube
+// rests with one vertex pointing straight up instead of sitting flat on a
+// face — the Rosetta Code pose the vertex ordering above is named for.
+func restTilt(v [3]float64) [3]float64 {
+	const thetaY = math.Pi / 4
+	sy, cy := math.Sin(thetaY), math.Cos(thetaY)
+	x, y, z := v[0], v[1], v[2]
+	x, z = x*cy+z*sy, -x*sy+z*cy
+
+	thetaZ := math.Atan2(math.Sqrt2, 1)
+	sz, cz := math.Sin(thetaZ), math.Cos(thetaZ)
+	x, y = x*cz-y*sz, x*sz+y*cz
+
+	return [3]float64{x, y, z}
+}
+
+// cubeEdges connects the corners above into the cube's 12 edges.
+var cubeEdges = [12][2]int{
+	{0, 1}, {1, 2}, {2, 3}, {3, 0}, // bottom face
+	{4, 5}, {5, 6}, {6, 7}, {7, 4}, // top face
+	{0, 4}, {1, 5}, {2, 6}, {3, 7}, // verticals joining the two faces
+}
+
+// Cube is a wireframe cube of half-edge-length Size. It has no surface to
+// sample, so instead it rasterises each of its 12 edges with a fixed
+// number of evenly spaced samples — a 3D analogue of a Bresenham line
+// walk — and emits one Point per sample, so wireframe edges z-test
+// against the shared frame buffer exactly like a solid torus's surface.
+type Cube struct {
+	Size                   float64
+	Center                 [3]float64 // lets a Cube orbit around another shape's origin
+	Dist                   float64
+	K1, K2                 float64
+	AX, AY, AZ             float64 // current rotation angles about each axis
+	SpeedX, SpeedY, SpeedZ float64
+	SamplesPerEdge         int
+	MaterialValue          light.Material
+}
+
+// NewCube builds a Cube of the given half-edge length, at rest at the
+// origin. Like NewTorus, K1/K2 are derived from the given screen size via
+// donut/torus.Params rather than hardcoded, so a standalone Cube still
+// projects at a sane scale instead of only looking right at one screen
+// size (R2 stands in for the cube's own half-extent, since Params' scale
+// formula only cares about the shape's reach from its centre).
+func NewCube(size float64, screenW, screenH int) *Cube {
+	const dist = 5
+
+	params := torus.Params{R2: size, ScreenW: screenW, ScreenH: screenH}
+	params.Compute(torus.Camera{Distance: dist})
+
+	return &Cube{
+		Size: size,
+		Dist: dist,
+		K1:   params.K1, K2: params.K2,
+		SamplesPerEdge: 24,
+		MaterialValue: light.Material{
+			Color: [3]float64{0.9, 0.9, 0.9}, Ambient: 0.15, Specular: 0.1, Shininess: 4,
+		},
+	}
+}
+
+// Material implements Shape.
+func (c *Cube) Material() light.Material { return c.MaterialValue }
+
+// Spin advances AX/AY/AZ by SpeedX/SpeedY/SpeedZ; call once per frame.
+func (c *Cube) Spin() {
+	c.AX += c.SpeedX
+	c.AY += c.SpeedY
+	c.AZ += c.SpeedZ
+}
+
+// rotate applies the cube's current X, then Y, then Z rotation to v.
+func (c *Cube) rotate(v [3]float64) [3]float64 {
+	sx, cx := math.Sin(c.AX), math.Cos(c.AX)
+	sy, cy := math.Sin(c.AY), math.Cos(c.AY)
+	sz, cz := math.Sin(c.AZ), math.Cos(c.AZ)
+
+	x, y, z := v[0], v[1], v[2]
+	y, z = y*cx-z*sx, y*sx+z*cx
+	x, z = x*cy+z*sy, -x*sy+z*cy
+	x, y = x*cz-y*sz, x*sz+y*cz
+	return [3]float64{x, y, z}
+}
+
+// vertex returns corner i's current position: scaled, rotated, then
+// translated to Center so the cube can orbit another shape.
+func (c *Cube) vertex(i int) [3]float64 {
+	s := cubeVertexSigns[i]
+	rest := restTilt([3]float64{s[0] * c.Size, s[1] * c.Size, s[2] * c.Size})
+	r := c.rotate(rest)
+	return [3]float64{r[0] + c.Center[0], r[1] + c.Center[1], r[2] + c.Center[2]}
+}
+
+// Walk implements Shape by rasterising each of the 12 edges independently.
+func (c *Cube) Walk(screenW, screenH int, emit func(Point)) {
+	n := c.SamplesPerEdge
+	if n < 2 {
+		n = 2
+	}
+	for _, edge := range cubeEdges {
+		a, b := c.vertex(edge[0]), c.vertex(edge[1])
+		dir := [3]float64{b[0] - a[0], b[1] - a[1], b[2] - a[2]}
+
+		for i := 0; i < n; i++ {
+			t := float64(i) / float64(n-1)
+			pos := [3]float64{a[0] + dir[0]*t, a[1] + dir[1]*t, a[2] + dir[2]*t}
+
+			zFactor := 1 / (c.Dist - pos[2])
+			sx := int(float64(screenW)/2 + c.K1*zFactor*pos[0])
+			sy := int(float64(screenH)/2 + c.K2*zFactor*pos[1])
+
+			// A wireframe edge has no true surface normal, so it's treated
+			// as always facing the camera rather than faked with the edge's
+			// own direction.
+			emit(Point{X: sx, Y: sy, Depth: zFactor, Pos: pos, Normal: [3]float64{0, 0, 1}})
+		}
+	}
+}
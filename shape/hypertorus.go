@@ -0,0 +1,175 @@
+package shape
+
+import (
+	"math"
+
+	"donut/light"
+)
+
+// Plane indices into Hypertorus.Angles / RotationSpeeds, one per independent
+// rotation in 4D. A 4D rigid rotation is a composition of up to six
+// simultaneous 2-plane rotations, unlike 3D's three.
+const (
+	PlaneXY = iota
+	PlaneXZ
+	PlaneXW
+	PlaneYZ
+	PlaneYW
+	PlaneZW
+	numPlanes
+)
+
+// Appearance selects how densely a Hypertorus samples its own surface,
+// which is how this ASCII renderer fakes wireframe/solid/transparent
+// materials: there's no real alpha channel, only glyph density.
+type Appearance int
+
+const (
+	Wireframe Appearance = iota
+	Solid
+	Transparent
+	Spirals
+)
+
+// Hypertorus is a duocylinder (the Cartesian product of two circles of
+// radius R1 and R2 living in orthogonal planes of R^4) rotated in all six
+// 4D coordinate planes, then projected 4D -> 3D -> 2D for display.
+//
+//	p(θ,φ) = (R1 cosθ, R1 sinθ, R2 cosφ, R2 sinφ)   θ,φ ∈ [0, 2π)
+//
+// is already a flat torus (every point is equidistant from the origin in
+// R^4), so unlike the 3D torus no extra "tube around a ring" composition
+// is needed; the interesting shape only appears once it is rotated in
+// planes that mix both circles (xz, xw, yz, yw) and projected down.
+type Hypertorus struct {
+	R1, R2            float64 // radii of the two orthogonal circles
+	ThetaSpacing      float64
+	PhiSpacing        float64
+	Dist4, Dist3      float64 // 4D and 3D camera distances used for the two perspective divides
+	K1, K2            float64 // 3D -> 2D screen scale, same convention as the classic torus K1
+	Angles            [numPlanes]float64
+	RotationSpeeds    [numPlanes]float64
+	Mode              Appearance
+	SpiralK, MeshStep int // line spacing for Spirals / Wireframe modes
+	MaterialValue     light.Material
+}
+
+// NewHypertorus builds a Hypertorus with the same visual scale as the
+// classic 3D donut, parked at rest (all six plane angles and speeds zero).
+func NewHypertorus(screenW, screenH int) *Hypertorus {
+	return &Hypertorus{
+		R1: 1, R2: 0.5,
+		ThetaSpacing: 0.07,
+		PhiSpacing:   0.04,
+		Dist4:        2.0,
+		Dist3:        5.0,
+		K1:           float64(screenW) * 3.0 / 8.0,
+		K2:           float64(screenH) * 3.0 / 8.0,
+		Mode:         Wireframe,
+		SpiralK:      6,
+		MeshStep:     8,
+		MaterialValue: light.Material{
+			Color: [3]float64{0.8, 0.2, 0.9}, Ambient: 0.08, Specular: 0.25, Shininess: 12,
+		},
+	}
+}
+
+// Material implements Shape.
+func (h *Hypertorus) Material() light.Material { return h.MaterialValue }
+
+// Spin advances every plane's angle by its configured RotationSpeed; call
+// once per frame. Kept separate from Walk so the trackball can change
+// RotationSpeeds between frames without racing the sampler.
+func (h *Hypertorus) Spin() {
+	for i := range h.Angles {
+		h.Angles[i] += h.RotationSpeeds[i]
+	}
+}
+
+// rotatePlane rotates the (a, b) coordinate pair of v by angle, in place.
+func rotatePlane(v *[4]float64, a, b int, angle float64) {
+	s, c := math.Sin(angle), math.Cos(angle)
+	va, vb := v[a], v[b]
+	v[a] = va*c - vb*s
+	v[b] = va*s + vb*c
+}
+
+var planeAxes = [numPlanes][2]int{
+	PlaneXY: {0, 1}, PlaneXZ: {0, 2}, PlaneXW: {0, 3},
+	PlaneYZ: {1, 2}, PlaneYW: {1, 3}, PlaneZW: {2, 3},
+}
+
+// rotate4 applies all six configured plane rotations to v, in place.
+func (h *Hypertorus) rotate4(v *[4]float64) {
+	for plane, axes := range planeAxes {
+		rotatePlane(v, axes[0], axes[1], h.Angles[plane])
+	}
+}
+
+// Walk implements Shape. It samples (θ, φ) over the duocylinder, rotates
+// the point and its surface normal together through all six 4D planes,
+// then projects 4D -> 3D with a perspective divide on w, and 3D -> 2D with
+// the usual perspective divide on z.
+func (h *Hypertorus) Walk(screenW, screenH int, emit func(Point)) {
+	thetaIdx := 0
+	for theta := 0.0; theta < 2*math.Pi; theta += h.ThetaSpacing {
+		sinT, cosT := math.Sin(theta), math.Cos(theta)
+		phiIdx := 0
+		for phi := 0.0; phi < 2*math.Pi; phi += h.PhiSpacing {
+			if !h.includeSample(thetaIdx, phiIdx) {
+				phiIdx++
+				continue
+			}
+			sinP, cosP := math.Sin(phi), math.Cos(phi)
+
+			point := [4]float64{h.R1 * cosT, h.R1 * sinT, h.R2 * cosP, h.R2 * sinP}
+			// The duocylinder's surface normal at (θ,φ) is just the unit
+			// radial direction in each circle's own plane.
+			normal := [4]float64{cosT, sinT, cosP, sinP}
+
+			h.rotate4(&point)
+			h.rotate4(&normal)
+
+			// 4D -> 3D: perspective divide on w.
+			wFactor := 1 / (h.Dist4 - point[3])
+			x3, y3, z3 := point[0]*wFactor, point[1]*wFactor, point[2]*wFactor
+
+			// 3D -> 2D: the same perspective divide the classic torus uses.
+			zFactor := 1 / (h.Dist3 - z3)
+			sx := int(float64(screenW)/2 + h.K1*zFactor*x3)
+			sy := int(float64(screenH)/2 + h.K2*zFactor*y3)
+
+			pos3 := [3]float64{x3, y3, z3}
+			normal3 := [3]float64{normal[0], normal[1], normal[2]}
+			emit(Point{X: sx, Y: sy, Depth: zFactor * wFactor, Pos: pos3, Normal: normal3})
+			phiIdx++
+		}
+		thetaIdx++
+	}
+}
+
+// includeSample applies the density rule for the current Appearance mode.
+func (h *Hypertorus) includeSample(thetaIdx, phiIdx int) bool {
+	switch h.Mode {
+	case Solid:
+		return true
+	case Transparent:
+		// Checkerboard dithering: drop every other sample so the glyph
+		// density reads as "see-through" rather than a solid skin.
+		return (thetaIdx+phiIdx)%2 == 0
+	case Spirals:
+		if h.SpiralK <= 0 {
+			return true
+		}
+		// Advancing the required theta-residue by one every SpiralK phi
+		// steps turns a straight band of constant theta into a diagonal
+		// one, so the surviving lines wind around the surface like spiral
+		// strands instead of a plain grid.
+		return (thetaIdx+phiIdx/h.SpiralK)%h.SpiralK == 0
+	default: // Wireframe
+		if h.MeshStep <= 0 {
+			return true
+		}
+		return thetaIdx%h.MeshStep == 0 || phiIdx%h.MeshStep == 0
+	}
+}
@@ -0,0 +1,33 @@
+// Package shape defines the parametric-surface contract shared by every
+// renderable object in the donut renderer (tori, hypertori, cubes, ...).
+package shape
+
+import "donut/light"
+
+// Point is a single surface sample produced while walking a Shape. X and Y
+// are already projected into screen space; Depth follows the same
+// convention as the original torus math (larger == closer to the camera,
+// used directly against a shared z-buffer); Pos is the sample's last 3D
+// position before screen projection, and Normal its surface normal there —
+// both in the same (unrotated-camera) space, for donut/light to shade.
+type Point struct {
+	X, Y   int
+	Depth  float64
+	Pos    [3]float64
+	Normal [3]float64
+}
+
+// Shape is a parametric surface that can be sampled onto the screen.
+// Implementations are responsible for their own rotation state and for
+// projecting their native coordinate space (3D, 4D, ...) down to the 2D
+// screen plus a depth value compatible with the shared z-buffer.
+type Shape interface {
+	// Walk samples the surface for the current rotation state, invoking
+	// emit once per point that the caller should z-test. screenW/screenH
+	// are passed through so implementations can do their own perspective
+	// projection and centring.
+	Walk(screenW, screenH int, emit func(Point))
+	// Material is the surface appearance donut/light shades this shape's
+	// samples with.
+	Material() light.Material
+}
@@ -0,0 +1,15 @@
+//go:build !unix
+
+package shape
+
+import "os"
+
+// termios is unused outside unix; kept so trackball.go doesn't need a
+// build-tagged declaration of its own.
+type termios struct{}
+
+// enableRawMode has no termios ioctl to call on this platform. Trackball
+// still works, but input stays line-buffered until Enter.
+func enableRawMode(f *os.File) (*termios, error) { return nil, nil }
+
+func restoreMode(f *os.File, orig *termios) {}
@@ -0,0 +1,51 @@
+//go:build unix
+
+package shape
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// ioctl requests and c_lflag bits for termios, per asm-generic/termbits.h.
+const (
+	tcgets = 0x5401
+	tcsets = 0x5402
+
+	lflagICANON = 0x2
+	lflagECHO   = 0x8
+)
+
+type termios struct {
+	Iflag, Oflag, Cflag, Lflag uint32
+	Line                       byte
+	Cc                         [32]byte
+	Ispeed, Ospeed             uint32
+}
+
+// enableRawMode switches f into cbreak mode: no line buffering, no local
+// echo, so single keypresses reach Trackball.Poll immediately instead of
+// only after Enter. It returns the prior state so the caller can restore
+// it with restoreMode.
+func enableRawMode(f *os.File) (*termios, error) {
+	var orig termios
+	if _, _, errno := syscall.Syscall(syscall.SYS_IOCTL, f.Fd(), tcgets, uintptr(unsafe.Pointer(&orig))); errno != 0 {
+		return nil, errno
+	}
+
+	raw := orig
+	raw.Lflag &^= lflagICANON | lflagECHO
+	raw.Cc[5] = 0 // VTIME: no inter-byte timeout
+	raw.Cc[6] = 1 // VMIN: a read returns after at least one byte
+
+	if _, _, errno := syscall.Syscall(syscall.SYS_IOCTL, f.Fd(), tcsets, uintptr(unsafe.Pointer(&raw))); errno != 0 {
+		return nil, errno
+	}
+	return &orig, nil
+}
+
+// restoreMode puts f back into the state enableRawMode saved.
+func restoreMode(f *os.File, orig *termios) {
+	syscall.Syscall(syscall.SYS_IOCTL, f.Fd(), tcsets, uintptr(unsafe.Pointer(orig)))
+}
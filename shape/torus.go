@@ -0,0 +1,85 @@
+package shape
+
+import (
+	"math"
+
+	"donut/light"
+	"donut/torus"
+)
+
+// Torus is the classic a1k0n-style donut: a tube of radius R1 swept around
+// a ring of radius R2, revolved by phi and rotated by the two angles A
+// (about the x-axis) and B (about the z-axis).
+type Torus struct {
+	R1, R2                   float64 // R1: tube (minor) radius, R2: ring (major) radius
+	ThetaSpacing, PhiSpacing float64 // theta: position around the tube, phi: revolve angle
+	Dist                     float64 // viewing distance
+	K1, K2                   float64 // screen-space scale; see donut/torus for how these should be derived
+	A, B                     float64 // current rotation angles
+	SpeedA, SpeedB           float64
+	MaterialValue            light.Material
+}
+
+// NewTorus builds a Torus at the original renderer's scale and rest pose,
+// with K1/K2 derived from the given screen size via donut/torus.Params
+// instead of hardcoded to a single screen size.
+func NewTorus(screenW, screenH int) *Torus {
+	const dist = 5
+	const r1, r2 = 1.0, 2.0
+
+	params := torus.Params{R1: r1, R2: r2, ScreenW: screenW, ScreenH: screenH}
+	params.Compute(torus.Camera{Distance: dist})
+
+	return &Torus{
+		R1: r1, R2: r2,
+		ThetaSpacing: 0.07, PhiSpacing: 0.02,
+		Dist: dist,
+		K1:   params.K1, K2: params.K2,
+		SpeedA: 0.07, SpeedB: 0.03,
+		MaterialValue: light.Material{
+			Color: [3]float64{0.1, 0.8, 0.9}, Ambient: 0.08, Specular: 0.3, Shininess: 16,
+		},
+	}
+}
+
+// Material implements Shape.
+func (t *Torus) Material() light.Material { return t.MaterialValue }
+
+// Spin advances A and B by SpeedA/SpeedB; call once per frame.
+func (t *Torus) Spin() {
+	t.A += t.SpeedA
+	t.B += t.SpeedB
+}
+
+// rotate applies the torus's current rotation (A about x, then B about z)
+// to v, in place semantics via return value.
+func (t *Torus) rotate(v [3]float64) [3]float64 {
+	sinA, cosA := math.Sin(t.A), math.Cos(t.A)
+	sinB, cosB := math.Sin(t.B), math.Cos(t.B)
+
+	x, y, z := v[0], v[1], v[2]
+	y, z = y*cosA-z*sinA, y*sinA+z*cosA
+	x, y = x*cosB-y*sinB, x*sinB+y*cosB
+	return [3]float64{x, y, z}
+}
+
+// Walk implements Shape.
+func (t *Torus) Walk(screenW, screenH int, emit func(Point)) {
+	for theta := 0.0; theta < 2*math.Pi; theta += t.ThetaSpacing {
+		sinTheta, cosTheta := math.Sin(theta), math.Cos(theta)
+		h := t.R2 + t.R1*cosTheta // distance from the torus's central axis at this theta
+
+		for phi := 0.0; phi < 2*math.Pi; phi += t.PhiSpacing {
+			sinPhi, cosPhi := math.Sin(phi), math.Cos(phi)
+
+			point := t.rotate([3]float64{h * cosPhi, h * sinPhi, t.R1 * sinTheta})
+			normal := t.rotate([3]float64{cosTheta * cosPhi, cosTheta * sinPhi, sinTheta})
+
+			d := 1 / (t.Dist - point[2])
+			x := int(float64(screenW)/2 + t.K1*d*point[0])
+			y := int(float64(screenH)/2 + t.K2*d*point[1])
+
+			emit(Point{X: x, Y: y, Depth: d, Pos: point, Normal: normal})
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package shape_test
+
+import (
+	"strings"
+	"testing"
+
+	"donut/light"
+	"donut/render"
+	"donut/shape"
+)
+
+// goldenTorusFrame is a 20x10 render of shape.NewTorus at rest (A=B=0),
+// lit by a single fixed directional light shining straight from the
+// camera into the scene. It pins down both the projection math (shape.Torus.Walk) and
+// the shading math (donut/light.Shade) together, so a regression in
+// either one fails this test.
+var goldenTorusFrame = []string{
+	"                    ",
+	"    ;!*######*!;    ",
+	"   !############!   ",
+	"  !###*!====!*###!  ",
+	"  !##!=;-  -;=!##!  ",
+	"  !##!=;-  -;=!##!  ",
+	"  !###*!====!*###!  ",
+	"   !############!   ",
+	"    ;!*######*!;    ",
+	"                    ",
+}
+
+func TestTorusGoldenFrame(t *testing.T) {
+	const width, height = 20, 10
+
+	tr := shape.NewTorus(width, height)
+	tr.A, tr.B = 0, 0
+
+	frame := render.NewFrame(width, height)
+	frame.Lights = []light.Light{light.Directional{Dir: [3]float64{0, 0, -1}, Intensity: 1}}
+	frame.ColorMode = light.ModeMono
+	frame.Clear()
+	frame.Composite(tr)
+
+	want := strings.Join(goldenTorusFrame, "")
+	got := string(frame.Cells())
+	if got != want {
+		t.Errorf("torus frame at rest does not match golden frame\ngot:\n%s\nwant:\n%s",
+			formatFrame(got, width), formatFrame(want, width))
+	}
+}
+
+func formatFrame(cells string, width int) string {
+	var b strings.Builder
+	for i := 0; i < len(cells); i += width {
+		b.WriteString(cells[i : i+width])
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
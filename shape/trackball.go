@@ -0,0 +1,115 @@
+package shape
+
+import (
+	"bufio"
+	"io"
+	"os"
+)
+
+// keySpeedDelta is how much a single keypress nudges a plane's rotation
+// speed; repeated presses accumulate, matching how a physical trackball
+// keeps spinning faster the longer you drag it.
+const keySpeedDelta = 0.01
+
+// planeKeys maps the keys that drive the trackball to the plane they spin
+// and the sign of the nudge. Arrow keys and WASD both drive the xw and yw
+// planes; Q/E drive zw. All three mix w into a 3D axis, so none is a
+// rotation a 3D torus has; the xy, xz and yz planes have no keys.
+var planeKeys = map[byte]struct {
+	plane int
+	sign  float64
+}{
+	'w': {PlaneXW, 1}, 's': {PlaneXW, -1},
+	'a': {PlaneYW, -1}, 'd': {PlaneYW, 1},
+	'q': {PlaneZW, -1}, 'e': {PlaneZW, 1},
+}
+
+// arrowKeys maps the final byte of a terminal arrow-key escape sequence
+// (ESC '[' A/B/C/D) onto the WASD key it's equivalent to, so readLoop can
+// feed both into the same planeKeys lookup.
+var arrowKeys = map[byte]byte{
+	'A': 'w', // up
+	'B': 's', // down
+	'C': 'd', // right
+	'D': 'a', // left
+}
+
+// Trackball reads single-key commands from r on a background goroutine and
+// applies them to a Hypertorus's RotationSpeeds, so the main render loop
+// never blocks waiting on stdin.
+type Trackball struct {
+	shape *Hypertorus
+	keys  chan byte
+	f     *os.File
+	saved *termios
+}
+
+// NewTrackball starts reading from r immediately. If r is an *os.File (as
+// os.Stdin is), NewTrackball switches it into cbreak mode itself so keys
+// arrive one byte at a time instead of after a newline; call Close to put
+// the terminal back the way it found it. For any other io.Reader,
+// Trackball just reads raw bytes and leaves terminal modes alone.
+func NewTrackball(shape *Hypertorus, r io.Reader) *Trackball {
+	t := &Trackball{shape: shape, keys: make(chan byte, 16)}
+	if f, ok := r.(*os.File); ok {
+		if saved, err := enableRawMode(f); err == nil {
+			t.f, t.saved = f, saved
+		}
+	}
+	go t.readLoop(bufio.NewReader(r))
+	return t
+}
+
+// Close restores the terminal mode NewTrackball saved, if any. Safe to
+// call on a Trackball built over a non-*os.File reader, where it's a
+// no-op.
+func (t *Trackball) Close() {
+	if t.f != nil && t.saved != nil {
+		restoreMode(t.f, t.saved)
+	}
+}
+
+func (t *Trackball) readLoop(br *bufio.Reader) {
+	for {
+		b, err := br.ReadByte()
+		if err != nil {
+			close(t.keys)
+			return
+		}
+		if b == 0x1b { // ESC: possibly the start of an arrow-key sequence
+			if b2, err := br.ReadByte(); err != nil {
+				return
+			} else if b2 != '[' {
+				continue // lone Esc or an escape sequence we don't handle
+			}
+			b3, err := br.ReadByte()
+			if err != nil {
+				return
+			}
+			if key, ok := arrowKeys[b3]; ok {
+				t.keys <- key
+			}
+			continue
+		}
+		t.keys <- b
+	}
+}
+
+// Poll drains every key received since the last call and applies it to the
+// shape's rotation speeds. It never blocks, so it's safe to call once per
+// frame from the render loop.
+func (t *Trackball) Poll() {
+	for {
+		select {
+		case b, ok := <-t.keys:
+			if !ok {
+				return
+			}
+			if cmd, known := planeKeys[b]; known {
+				t.shape.RotationSpeeds[cmd.plane] += keySpeedDelta * cmd.sign
+			}
+		default:
+			return
+		}
+	}
+}
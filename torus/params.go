@@ -0,0 +1,36 @@
+// Package torus holds the torus projection math as reusable, testable
+// values instead of constants buried inside the render loop: the
+// "15"/"7" screen-space scale the original renderer hardcoded only ever
+// looked right at exactly a 40x20 screen, because it wasn't actually
+// derived from the screen size or the torus's own radii.
+package torus
+
+// Camera describes the viewpoint a torus is projected from.
+type Camera struct {
+	Distance float64 // distance from the camera to the torus's centre
+	FOV      float64 // horizontal field of view, in radians (currently informational; Compute uses Distance)
+}
+
+// Params holds a torus's geometry and the screen-space scale it should be
+// drawn at. K1 and K2 are left for Compute to fill in; setting them
+// directly is only useful for reproducing a specific existing projection
+// (e.g. a golden-file test).
+type Params struct {
+	R1, R2           float64
+	ScreenW, ScreenH int
+	K1, K2           float64
+}
+
+// Compute derives K1 from ScreenW, cam's distance, and the torus's own
+// radii, the way the canonical reference implementation does:
+//
+//	K1 = screenWidth * distance * 3 / (8 * (R1 + R2))
+//
+// so the torus fills the same fraction of the screen regardless of screen
+// size. K2 is K1 halved to compensate for terminal character cells being
+// roughly twice as tall as they are wide, keeping the projection circular
+// rather than squashed.
+func (p *Params) Compute(cam Camera) {
+	p.K1 = float64(p.ScreenW) * cam.Distance * 3 / (8 * (p.R1 + p.R2))
+	p.K2 = p.K1 / 2
+}